@@ -0,0 +1,82 @@
+package strutil
+
+import "testing"
+
+func TestBeforeAfterNotFound(t *testing.T) {
+	if got := Before("foo", "z"); got != "foo" {
+		t.Errorf("Before(%q, %q) = %q, want %q", "foo", "z", got, "foo")
+	}
+	if got := BeforeLast("foo", "z"); got != "foo" {
+		t.Errorf("BeforeLast(%q, %q) = %q, want %q", "foo", "z", got, "foo")
+	}
+	if got := After("foo", "z"); got != "foo" {
+		t.Errorf("After(%q, %q) = %q, want %q", "foo", "z", got, "foo")
+	}
+	if got := AfterLast("foo", "z"); got != "foo" {
+		t.Errorf("AfterLast(%q, %q) = %q, want %q", "foo", "z", got, "foo")
+	}
+}
+
+func TestBeforeAfterE(t *testing.T) {
+	if got, ok := BeforeE("foo.bar", "."); !ok || got != "foo" {
+		t.Errorf("BeforeE = (%q, %v), want (%q, true)", got, ok, "foo")
+	}
+	if _, ok := BeforeE("foo", "z"); ok {
+		t.Errorf("BeforeE found = true, want false")
+	}
+	if got, ok := AfterE("foo.bar", "."); !ok || got != "bar" {
+		t.Errorf("AfterE = (%q, %v), want (%q, true)", got, ok, "bar")
+	}
+	if _, ok := AfterE("foo", "z"); ok {
+		t.Errorf("AfterE found = true, want false")
+	}
+}
+
+func TestBeforeLastAfterLastE(t *testing.T) {
+	if got, ok := BeforeLastE("foo.bar.baz", "."); !ok || got != "foo.bar" {
+		t.Errorf("BeforeLastE = (%q, %v), want (%q, true)", got, ok, "foo.bar")
+	}
+	if _, ok := BeforeLastE("foo", "z"); ok {
+		t.Errorf("BeforeLastE found = true, want false")
+	}
+	if got, ok := AfterLastE("foo.bar.baz", "."); !ok || got != "baz" {
+		t.Errorf("AfterLastE = (%q, %v), want (%q, true)", got, ok, "baz")
+	}
+	if _, ok := AfterLastE("foo", "z"); ok {
+		t.Errorf("AfterLastE found = true, want false")
+	}
+}
+
+func TestBetween(t *testing.T) {
+	cases := []struct {
+		s, left, right string
+		expect         string
+	}{
+		{"${name}", "${", "}", "name"},
+		{`say "hello" now`, `"`, `"`, "hello"},
+		{"no markers", "${", "}", ""},
+	}
+
+	for _, c := range cases {
+		if got := Between(c.s, c.left, c.right); got != c.expect {
+			t.Errorf("Between(%q, %q, %q) = %q, want %q", c.s, c.left, c.right, got, c.expect)
+		}
+	}
+}
+
+func TestBetweenAll(t *testing.T) {
+	got := BetweenAll("${a}-${b}-${c}", "${", "}")
+	want := []string{"a", "b", "c"}
+	if len(got) != len(want) {
+		t.Fatalf("BetweenAll = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("BetweenAll[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	if got := BetweenAll("no markers", "${", "}"); got != nil {
+		t.Errorf("BetweenAll with no markers = %v, want nil", got)
+	}
+}
@@ -0,0 +1,81 @@
+package strutil
+
+import "testing"
+
+func TestExpand(t *testing.T) {
+	mapping := func(name string) string {
+		switch name {
+		case "name":
+			return "gopher"
+		default:
+			return ""
+		}
+	}
+
+	cases := []struct {
+		template string
+		expect   string
+	}{
+		{"hello $name", "hello gopher"},
+		{"hello ${name}", "hello gopher"},
+		{"hello ${missing:-world}", "hello world"},
+		{"hello ${name:-world}", "hello gopher"},
+		{"no vars here", "no vars here"},
+	}
+
+	for _, c := range cases {
+		if got := Expand(c.template, mapping); got != c.expect {
+			t.Errorf("Expand(%q) = %q, want %q", c.template, got, c.expect)
+		}
+	}
+}
+
+func TestExpandE(t *testing.T) {
+	mapping := func(name string) string { return "" }
+
+	got, err := ExpandE("${required:?required is missing}", mapping)
+	if err == nil || err.Error() != "required is missing" {
+		t.Fatalf("ExpandE error = %v, want %q", err, "required is missing")
+	}
+	if got != "" {
+		t.Fatalf("ExpandE result = %q, want %q", got, "")
+	}
+
+	got, err = ExpandE("hello ${name:-world}", mapping)
+	if err != nil {
+		t.Fatalf("ExpandE unexpected error: %v", err)
+	}
+	if got != "hello world" {
+		t.Fatalf("ExpandE result = %q, want %q", got, "hello world")
+	}
+}
+
+func TestExpandEnv(t *testing.T) {
+	t.Setenv("STRUTIL_EXPAND_TEST", "value")
+	if got := ExpandEnv("x=${STRUTIL_EXPAND_TEST}"); got != "x=value" {
+		t.Errorf("ExpandEnv = %q, want %q", got, "x=value")
+	}
+}
+
+func TestReplaces(t *testing.T) {
+	pairs := map[string]string{"a": "1", "b": "2"}
+	if got := Replaces("a-b-a", pairs); got != "1-2-1" {
+		t.Errorf("Replaces = %q, want %q", got, "1-2-1")
+	}
+}
+
+func TestNewReplacer(t *testing.T) {
+	r := NewReplacer(map[string]string{"foo": "bar"})
+	if got := r.Replace("foofoo"); got != "barbar" {
+		t.Errorf("NewReplacer().Replace = %q, want %q", got, "barbar")
+	}
+}
+
+func TestReplacesPrefixKeysAreDeterministic(t *testing.T) {
+	pairs := map[string]string{"a": "X", "ab": "Y"}
+	for i := 0; i < 50; i++ {
+		if got := Replaces("ab", pairs); got != "Y" {
+			t.Fatalf("Replaces(%q, %v) = %q, want %q", "ab", pairs, got, "Y")
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package strutil
+
+import "testing"
+
+func TestIndent(t *testing.T) {
+	cases := []struct {
+		input  string
+		prefix string
+		width  int
+		opts   []IndentOption
+		expect string
+	}{
+		{"a\nb\n\nc", "  ", 1, nil, "  a\n  b\n\n  c"},
+		{"a\nb\n\nc", "  ", 1, []IndentOption{WithIndentEmptyLines()}, "  a\n  b\n  \n  c"},
+		{"a\r\nb", ">", 2, nil, ">>a\r\n>>b"},
+	}
+
+	for _, c := range cases {
+		if got := Indent(c.input, c.prefix, c.width, c.opts...); got != c.expect {
+			t.Errorf("Indent(%q, %q, %d) = %q, want %q", c.input, c.prefix, c.width, got, c.expect)
+		}
+	}
+}
+
+func TestDedent(t *testing.T) {
+	cases := []struct {
+		input  string
+		expect string
+	}{
+		{"    a\n    b\n\n      c\n", "a\nb\n\n  c\n"},
+		{"a\nb", "a\nb"},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		if got := Dedent(c.input); got != c.expect {
+			t.Errorf("Dedent(%q) = %q, want %q", c.input, got, c.expect)
+		}
+	}
+}
+
+func TestWordsIter(t *testing.T) {
+	var got []string
+	for w := range WordsIter("Hello, you're 2 awesome-people!") {
+		got = append(got, w)
+	}
+
+	want := SplitWords("Hello, you're 2 awesome-people!")
+	if len(got) != len(want) {
+		t.Fatalf("WordsIter = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("WordsIter[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSplitIter(t *testing.T) {
+	var got []string
+	for w := range SplitIter("a,b,,c", ",") {
+		got = append(got, w)
+	}
+
+	want := []string{"a", "b", "", "c"}
+	if len(got) != len(want) {
+		t.Fatalf("SplitIter = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("SplitIter[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
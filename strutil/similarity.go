@@ -0,0 +1,207 @@
+// Copyright 2021 dudaodong@gmail.com. All rights reserved.
+// Use of this source code is governed by MIT license
+
+package strutil
+
+// Levenshtein returns the minimum number of single-rune insertions,
+// deletions and substitutions needed to turn a into b.
+func Levenshtein(a, b string) int {
+	ra := []rune(a)
+	rb := []rune(b)
+
+	if len(ra) < len(rb) {
+		ra, rb = rb, ra
+	}
+	if len(rb) == 0 {
+		return len(ra)
+	}
+
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, min(cur[j-1]+1, prev[j-1]+cost))
+		}
+		prev = cur
+	}
+
+	return prev[len(rb)]
+}
+
+// DamerauLevenshtein returns the Levenshtein distance extended with
+// adjacent-transposition as a single edit, e.g. DamerauLevenshtein("ab",
+// "ba") is 1 instead of the 2 that Levenshtein would report.
+func DamerauLevenshtein(a, b string) int {
+	ra := []rune(a)
+	rb := []rune(b)
+	la, lb := len(ra), len(rb)
+
+	dp := make([][]int, la+1)
+	for i := range dp {
+		dp[i] = make([]int, lb+1)
+		dp[i][0] = i
+	}
+	for j := 0; j <= lb; j++ {
+		dp[0][j] = j
+	}
+
+	for i := 1; i <= la; i++ {
+		for j := 1; j <= lb; j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			dp[i][j] = min(dp[i-1][j]+1, min(dp[i][j-1]+1, dp[i-1][j-1]+cost))
+
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				dp[i][j] = min(dp[i][j], dp[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return dp[la][lb]
+}
+
+// JaroWinkler returns the Jaro-Winkler similarity of a and b in [0, 1],
+// where 1 means identical. It boosts the plain Jaro similarity by
+// p*l*(1-jaro) for a common prefix of length l (capped at 4) with p=0.1.
+func JaroWinkler(a, b string) float64 {
+	ra := []rune(a)
+	rb := []rune(b)
+
+	j := jaroSimilarity(ra, rb)
+
+	const maxPrefix = 4
+	const p = 0.1
+
+	prefix := 0
+	for prefix < len(ra) && prefix < len(rb) && prefix < maxPrefix && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+
+	return j + float64(prefix)*p*(1-j)
+}
+
+// jaroSimilarity returns the Jaro similarity of a and b in [0, 1].
+func jaroSimilarity(a, b []rune) float64 {
+	la, lb := len(a), len(b)
+	if la == 0 && lb == 0 {
+		return 1
+	}
+	if la == 0 || lb == 0 {
+		return 0
+	}
+
+	matchDistance := max(la, lb)/2 - 1
+	if matchDistance < 0 {
+		matchDistance = 0
+	}
+
+	aMatches := make([]bool, la)
+	bMatches := make([]bool, lb)
+
+	matches := 0
+	for i := 0; i < la; i++ {
+		start := max(0, i-matchDistance)
+		end := min(lb, i+matchDistance+1)
+		for j := start; j < end; j++ {
+			if bMatches[j] || a[i] != b[j] {
+				continue
+			}
+			aMatches[i] = true
+			bMatches[j] = true
+			matches++
+			break
+		}
+	}
+
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	k := 0
+	for i := 0; i < la; i++ {
+		if !aMatches[i] {
+			continue
+		}
+		for !bMatches[k] {
+			k++
+		}
+		if a[i] != b[k] {
+			transpositions++
+		}
+		k++
+	}
+	transpositions /= 2
+
+	m := float64(matches)
+	return (m/float64(la) + m/float64(lb) + (m-float64(transpositions))/m) / 3
+}
+
+// LongestCommonSubsequence returns the longest subsequence common to both
+// a and b (not necessarily contiguous in either).
+func LongestCommonSubsequence(a, b string) string {
+	ra := []rune(a)
+	rb := []rune(b)
+	la, lb := len(ra), len(rb)
+
+	dp := make([][]int, la+1)
+	for i := range dp {
+		dp[i] = make([]int, lb+1)
+	}
+	for i := 1; i <= la; i++ {
+		for j := 1; j <= lb; j++ {
+			if ra[i-1] == rb[j-1] {
+				dp[i][j] = dp[i-1][j-1] + 1
+			} else {
+				dp[i][j] = max(dp[i-1][j], dp[i][j-1])
+			}
+		}
+	}
+
+	lcs := make([]rune, dp[la][lb])
+	for i, j := la, lb; i > 0 && j > 0; {
+		switch {
+		case ra[i-1] == rb[j-1]:
+			lcs[dp[i][j]-1] = ra[i-1]
+			i--
+			j--
+		case dp[i-1][j] >= dp[i][j-1]:
+			i--
+		default:
+			j--
+		}
+	}
+
+	return string(lcs)
+}
+
+// ClosestMatch returns the candidate closest to input by Levenshtein
+// distance, along with that distance. It returns ("", 0) if candidates is empty.
+func ClosestMatch(input string, candidates []string) (best string, score int) {
+	if len(candidates) == 0 {
+		return "", 0
+	}
+
+	best = candidates[0]
+	score = Levenshtein(input, best)
+
+	for _, c := range candidates[1:] {
+		if d := Levenshtein(input, c); d < score {
+			best = c
+			score = d
+		}
+	}
+
+	return best, score
+}
@@ -0,0 +1,92 @@
+package strutil
+
+import "testing"
+
+func TestLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b   string
+		expect int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"你好世界", "你好", 2},
+		{"café", "café", 2}, // precomposed "é" vs "e" + combining acute accent
+	}
+
+	for _, c := range cases {
+		if got := Levenshtein(c.a, c.b); got != c.expect {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", c.a, c.b, got, c.expect)
+		}
+	}
+}
+
+func TestDamerauLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b   string
+		expect int
+	}{
+		{"", "", 0},
+		{"ab", "ba", 1},
+		{"ca", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"你好", "好你", 1},
+	}
+
+	for _, c := range cases {
+		if got := DamerauLevenshtein(c.a, c.b); got != c.expect {
+			t.Errorf("DamerauLevenshtein(%q, %q) = %d, want %d", c.a, c.b, got, c.expect)
+		}
+	}
+}
+
+func TestJaroWinkler(t *testing.T) {
+	cases := []struct {
+		a, b   string
+		expect float64
+	}{
+		{"", "", 1},
+		{"", "abc", 0},
+		{"martha", "marhta", 0.9611111111111111},
+		{"dixon", "dicksonx", 0.8133333333333332},
+		{"identical", "identical", 1},
+	}
+
+	for _, c := range cases {
+		got := JaroWinkler(c.a, c.b)
+		if diff := got - c.expect; diff < -1e-9 || diff > 1e-9 {
+			t.Errorf("JaroWinkler(%q, %q) = %v, want %v", c.a, c.b, got, c.expect)
+		}
+	}
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	cases := []struct {
+		a, b   string
+		expect string
+	}{
+		{"", "", ""},
+		{"abc", "", ""},
+		{"ABCBDAB", "BDCABA", "BCBA"},
+		{"你好世界", "你世界好", "你世界"},
+	}
+
+	for _, c := range cases {
+		if got := LongestCommonSubsequence(c.a, c.b); got != c.expect {
+			t.Errorf("LongestCommonSubsequence(%q, %q) = %q, want %q", c.a, c.b, got, c.expect)
+		}
+	}
+}
+
+func TestClosestMatch(t *testing.T) {
+	best, score := ClosestMatch("aple", []string{"apple", "orange", "grape"})
+	if best != "apple" || score != 1 {
+		t.Errorf("ClosestMatch = (%q, %d), want (%q, %d)", best, score, "apple", 1)
+	}
+
+	if best, score := ClosestMatch("x", nil); best != "" || score != 0 {
+		t.Errorf("ClosestMatch with no candidates = (%q, %d), want (%q, %d)", best, score, "", 0)
+	}
+}
@@ -5,6 +5,10 @@
 package strutil
 
 import (
+	"errors"
+	"iter"
+	"os"
+	"sort"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -14,18 +18,14 @@ import (
 // non letters and numbers will be ignored
 // eg. "Foo-#1😄$_%^&*(1bar" => "foo11Bar"
 func CamelCase(s string) string {
-	var builder strings.Builder
-
-	strs := splitIntoStrings(s, false)
-	for i, str := range strs {
-		if i == 0 {
-			builder.WriteString(strings.ToLower(str))
-		} else {
-			builder.WriteString(Capitalize(str))
-		}
-	}
+	return defaultCaseConverter.Camel(s)
+}
 
-	return builder.String()
+// PascalCase covert string to PascalCase (UpperCamelCase) string.
+// non letters and numbers will be ignored
+// eg. "Foo-#1😄$_%^&*(1bar" => "Foo11Bar"
+func PascalCase(s string) string {
+	return defaultCaseConverter.Pascal(s)
 }
 
 // Capitalize converts the first character of a string to upper case and the remaining to lower case.
@@ -104,8 +104,7 @@ func UpperKebabCase(s string) string {
 // non letters and numbers will be ignored
 // eg. "Foo-#1😄$_%^&*(1bar" => "foo_1_1_bar"
 func SnakeCase(s string) string {
-	result := splitIntoStrings(s, false)
-	return strings.Join(result, "_")
+	return defaultCaseConverter.Snake(s)
 }
 
 // UpperSnakeCase covert string to upper SNAKE_CASE
@@ -116,40 +115,323 @@ func UpperSnakeCase(s string) string {
 	return strings.Join(result, "_")
 }
 
-// Before create substring in source string before position when char first appear
+// CaseConverter converts strings between naming conventions with
+// configurable initialisms and digit boundaries. Use NewCaseConverter to
+// build one with the historical splitIntoStrings defaults.
+type CaseConverter struct {
+	// Initialisms are words such as "URL" or "HTTP" that are matched
+	// case-insensitively against whole tokens after splitting. Camel (after
+	// its first word), Pascal and Train render a match in its given casing;
+	// Snake, ScreamingSnake, Kebab, Dot and Path use uniform case. An
+	// initialism that splitting breaks apart, such as "S3" when
+	// SplitOnDigits is set, is not recognized.
+	Initialisms []string
+
+	// Acronyms maps a word (case-insensitive) to the exact casing to render it with.
+	Acronyms map[string]string
+
+	// SplitOnDigits treats a letter/digit boundary as a word boundary.
+	SplitOnDigits bool
+}
+
+// NewCaseConverter returns a CaseConverter with the historical splitIntoStrings defaults.
+func NewCaseConverter() *CaseConverter {
+	return &CaseConverter{SplitOnDigits: true}
+}
+
+// defaultCaseConverter backs CamelCase, PascalCase and SnakeCase.
+var defaultCaseConverter = NewCaseConverter()
+
+// caseToken is a word produced while tokenizing a string for case conversion.
+// fixed marks a token whose casing must be preserved as-is.
+type caseToken struct {
+	text  string
+	fixed bool
+}
+
+// tokens splits s into caseTokens honoring this converter's Initialisms,
+// Acronyms and SplitOnDigits options.
+func (c *CaseConverter) tokens(s string) []caseToken {
+	initialisms := make(map[string]string, len(c.Initialisms))
+	for _, w := range c.Initialisms {
+		if w == "" {
+			continue
+		}
+		initialisms[strings.ToLower(w)] = w
+	}
+
+	toks := splitCaseTokens(s, initialisms, c.SplitOnDigits)
+	if c.Acronyms == nil {
+		return toks
+	}
+	acronyms := make(map[string]string, len(c.Acronyms))
+	for k, v := range c.Acronyms {
+		acronyms[strings.ToLower(k)] = v
+	}
+	for i, t := range toks {
+		if v, ok := acronyms[strings.ToLower(t.text)]; ok {
+			toks[i] = caseToken{text: v, fixed: true}
+		}
+	}
+	return toks
+}
+
+// Camel converts s to camelCase using this converter's options.
+func (c *CaseConverter) Camel(s string) string {
+	toks := c.tokens(s)
+	var b strings.Builder
+	for i, t := range toks {
+		switch {
+		case i == 0:
+			b.WriteString(strings.ToLower(t.text))
+		case t.fixed:
+			b.WriteString(t.text)
+		default:
+			b.WriteString(Capitalize(t.text))
+		}
+	}
+	return b.String()
+}
+
+// Pascal converts s to PascalCase (UpperCamelCase) using this converter's options.
+func (c *CaseConverter) Pascal(s string) string {
+	toks := c.tokens(s)
+	var b strings.Builder
+	for _, t := range toks {
+		if t.fixed {
+			b.WriteString(t.text)
+		} else {
+			b.WriteString(Capitalize(t.text))
+		}
+	}
+	return b.String()
+}
+
+// join renders tokens uniformly upper- or lower-cased and joins them with sep.
+func (c *CaseConverter) join(s, sep string, upper bool) string {
+	toks := c.tokens(s)
+	words := make([]string, len(toks))
+	for i, t := range toks {
+		if upper {
+			words[i] = strings.ToUpper(t.text)
+		} else {
+			words[i] = strings.ToLower(t.text)
+		}
+	}
+	return strings.Join(words, sep)
+}
+
+// Snake converts s to snake_case using this converter's options.
+func (c *CaseConverter) Snake(s string) string { return c.join(s, "_", false) }
+
+// ScreamingSnake converts s to SCREAMING_SNAKE_CASE using this converter's options.
+func (c *CaseConverter) ScreamingSnake(s string) string { return c.join(s, "_", true) }
+
+// Kebab converts s to kebab-case using this converter's options.
+func (c *CaseConverter) Kebab(s string) string { return c.join(s, "-", false) }
+
+// Dot converts s to dot.case using this converter's options.
+func (c *CaseConverter) Dot(s string) string { return c.join(s, ".", false) }
+
+// Path converts s to path/case using this converter's options.
+func (c *CaseConverter) Path(s string) string { return c.join(s, "/", false) }
+
+// Train converts s to Train-Case using this converter's options.
+func (c *CaseConverter) Train(s string) string {
+	toks := c.tokens(s)
+	words := make([]string, len(toks))
+	for i, t := range toks {
+		if t.fixed {
+			words[i] = t.text
+		} else {
+			words[i] = Capitalize(t.text)
+		}
+	}
+	return strings.Join(words, "-")
+}
+
+// splitCaseTokens breaks s into word tokens, splitting on non-alphanumeric
+// runs, case transitions, acronym boundaries (e.g. "HTTPServer" -> "HTTP",
+// "Server") and - when splitOnDigits is true - letter/digit boundaries.
+func splitCaseTokens(s string, initialisms map[string]string, splitOnDigits bool) []caseToken {
+	runes := []rune(s)
+	n := len(runes)
+
+	classOf := func(r rune) int {
+		switch {
+		case unicode.IsDigit(r):
+			return 2
+		case unicode.IsLetter(r):
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	var tokens []caseToken
+	var cur []rune
+
+	flush := func() {
+		if len(cur) == 0 {
+			return
+		}
+		text := string(cur)
+		if canon, ok := initialisms[strings.ToLower(text)]; ok {
+			tokens = append(tokens, caseToken{text: canon, fixed: true})
+		} else {
+			tokens = append(tokens, caseToken{text: text})
+		}
+		cur = cur[:0]
+	}
+
+	for i := 0; i < n; i++ {
+		r := runes[i]
+		class := classOf(r)
+		if class == 0 {
+			flush()
+			continue
+		}
+
+		if len(cur) > 0 {
+			prev := cur[len(cur)-1]
+			prevClass := classOf(prev)
+			boundary := false
+			switch {
+			case class != prevClass:
+				boundary = splitOnDigits
+			case class == 1:
+				if unicode.IsLower(prev) && unicode.IsUpper(r) {
+					boundary = true
+				} else if unicode.IsUpper(prev) && unicode.IsUpper(r) && i+1 < n && unicode.IsLower(runes[i+1]) {
+					boundary = true
+				}
+			}
+			if boundary {
+				flush()
+			}
+		}
+
+		cur = append(cur, r)
+	}
+	flush()
+
+	return tokens
+}
+
+// Before create substring in source string before position when char first appear.
+// Returns s unchanged if char is not found.
 func Before(s, char string) string {
+	result, _ := BeforeE(s, char)
+	return result
+}
+
+// BeforeE is like Before but also reports whether char was found in s.
+func BeforeE(s, char string) (string, bool) {
 	if s == "" || char == "" {
-		return s
+		return s, false
 	}
 	i := strings.Index(s, char)
-	return s[0:i]
+	if i == -1 {
+		return s, false
+	}
+	return s[0:i], true
 }
 
-// BeforeLast create substring in source string before position when char last appear
+// BeforeLast create substring in source string before position when char last appear.
+// Returns s unchanged if char is not found.
 func BeforeLast(s, char string) string {
+	result, _ := BeforeLastE(s, char)
+	return result
+}
+
+// BeforeLastE is like BeforeLast but also reports whether char was found in s.
+func BeforeLastE(s, char string) (string, bool) {
 	if s == "" || char == "" {
-		return s
+		return s, false
 	}
 	i := strings.LastIndex(s, char)
-	return s[0:i]
+	if i == -1 {
+		return s, false
+	}
+	return s[0:i], true
 }
 
-// After create substring in source string after position when char first appear
+// After create substring in source string after position when char first appear.
+// Returns s unchanged if char is not found.
 func After(s, char string) string {
+	result, _ := AfterE(s, char)
+	return result
+}
+
+// AfterE is like After but also reports whether char was found in s.
+func AfterE(s, char string) (string, bool) {
 	if s == "" || char == "" {
-		return s
+		return s, false
 	}
 	i := strings.Index(s, char)
-	return s[i+len(char):]
+	if i == -1 {
+		return s, false
+	}
+	return s[i+len(char):], true
 }
 
-// AfterLast create substring in source string after position when char last appear
+// AfterLast create substring in source string after position when char last appear.
+// Returns s unchanged if char is not found.
 func AfterLast(s, char string) string {
+	result, _ := AfterLastE(s, char)
+	return result
+}
+
+// AfterLastE is like AfterLast but also reports whether char was found in s.
+func AfterLastE(s, char string) (string, bool) {
 	if s == "" || char == "" {
-		return s
+		return s, false
 	}
 	i := strings.LastIndex(s, char)
-	return s[i+len(char):]
+	if i == -1 {
+		return s, false
+	}
+	return s[i+len(char):], true
+}
+
+// Between extracts the substring of s between the first occurrence of left
+// and the following occurrence of right. Returns "" if either is missing.
+func Between(s, left, right string) string {
+	if s == "" || left == "" || right == "" {
+		return ""
+	}
+	after, ok := AfterE(s, left)
+	if !ok {
+		return ""
+	}
+	before, ok := BeforeE(after, right)
+	if !ok {
+		return ""
+	}
+	return before
+}
+
+// BetweenAll extracts every substring of s delimited by left and right,
+// e.g. BetweenAll("${a}-${b}", "${", "}") => []string{"a", "b"}.
+func BetweenAll(s, left, right string) []string {
+	if s == "" || left == "" || right == "" {
+		return nil
+	}
+
+	var result []string
+	for {
+		after, ok := AfterE(s, left)
+		if !ok {
+			break
+		}
+		before, ok := BeforeE(after, right)
+		if !ok {
+			break
+		}
+		result = append(result, before)
+		s = after[len(before)+len(right):]
+	}
+	return result
 }
 
 // IsString check if the value data type is string or not.
@@ -205,6 +487,129 @@ func Unwrap(str string, wrapToken string) string {
 	return str
 }
 
+// Expand replaces ${var}, ${var:-default} and $var references in template
+// with the result of calling mapping on each variable name. A
+// ${var:?message} reference whose mapping returns "" expands to ""; use
+// ExpandE to get message back as an error instead.
+func Expand(template string, mapping func(string) string) string {
+	result, _ := expand(template, mapping, false)
+	return result
+}
+
+// ExpandEnv is Expand using os.Getenv as the mapping function.
+func ExpandEnv(template string) string {
+	return Expand(template, os.Getenv)
+}
+
+// ExpandE is like Expand but returns an error carrying message when
+// template contains a ${var:?message} reference whose mapping returns "".
+func ExpandE(template string, mapping func(string) string) (string, error) {
+	return expand(template, mapping, true)
+}
+
+// expand implements Expand/ExpandE. strict controls whether a
+// ${var:?message} miss returns an error or expands to "".
+func expand(template string, mapping func(string) string, strict bool) (string, error) {
+	var b strings.Builder
+
+	for i := 0; i < len(template); {
+		c := template[i]
+		if c != '$' || i+1 >= len(template) {
+			b.WriteByte(c)
+			i++
+			continue
+		}
+
+		if template[i+1] == '{' {
+			end := strings.IndexByte(template[i+2:], '}')
+			if end < 0 {
+				b.WriteByte(c)
+				i++
+				continue
+			}
+			val, err := expandExpr(template[i+2:i+2+end], mapping, strict)
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(val)
+			i += 2 + end + 1
+			continue
+		}
+
+		j := i + 1
+		for j < len(template) && isShellVarNameByte(template[j]) {
+			j++
+		}
+		if j == i+1 {
+			b.WriteByte(c)
+			i++
+			continue
+		}
+		b.WriteString(mapping(template[i+1 : j]))
+		i = j
+	}
+
+	return b.String(), nil
+}
+
+// expandExpr resolves a single ${...} body: "var", "var:-default" or "var:?message".
+func expandExpr(expr string, mapping func(string) string, strict bool) (string, error) {
+	if idx := strings.Index(expr, ":-"); idx >= 0 {
+		if v := mapping(expr[:idx]); v != "" {
+			return v, nil
+		}
+		return expr[idx+2:], nil
+	}
+
+	if idx := strings.Index(expr, ":?"); idx >= 0 {
+		if v := mapping(expr[:idx]); v != "" {
+			return v, nil
+		}
+		if strict {
+			return "", errors.New(expr[idx+2:])
+		}
+		return "", nil
+	}
+
+	return mapping(expr), nil
+}
+
+// isShellVarNameByte reports whether b can appear in a bare $var name.
+func isShellVarNameByte(b byte) bool {
+	return b == '_' || '0' <= b && b <= '9' || 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z'
+}
+
+// Replaces performs every substitution in pairs on s in a single pass,
+// instead of chaining strings.ReplaceAll which would re-scan replaced output.
+func Replaces(s string, pairs map[string]string) string {
+	return NewReplacer(pairs).Replace(s)
+}
+
+// NewReplacer builds a *strings.Replacer from pairs so callers can run
+// multiple substitutions over a string in one pass. Keys are ordered
+// longest-first (then lexicographically) before being handed to
+// strings.NewReplacer, so when one key is a prefix of another the more
+// specific, longer match consistently wins instead of depending on map
+// iteration order.
+func NewReplacer(pairs map[string]string) *strings.Replacer {
+	keys := make([]string, 0, len(pairs))
+	for k := range pairs {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	oldnew := make([]string, 0, len(pairs)*2)
+	for _, k := range keys {
+		oldnew = append(oldnew, k, pairs[k])
+	}
+	return strings.NewReplacer(oldnew...)
+}
+
 // SplitEx split a given string whether the result contains empty string
 func SplitEx(s, sep string, removeEmptyString bool) []string {
 	if sep == "" {
@@ -295,6 +700,234 @@ func SplitWords(s string) []string {
 	return words
 }
 
+// WordsIter returns an iterator over the words in s, the same words
+// SplitWords returns, without allocating an intermediate slice.
+func WordsIter(s string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		var word string
+		var r rune
+		var size, pos int
+
+		isWord := false
+
+		for len(s) > 0 {
+			r, size = utf8.DecodeRuneInString(s)
+
+			switch {
+			case isLetter(r):
+				if !isWord {
+					isWord = true
+					word = s
+					pos = 0
+				}
+
+			case isWord && (r == '\'' || r == '-'):
+				// is word
+
+			default:
+				if isWord {
+					isWord = false
+					if !yield(word[:pos]) {
+						return
+					}
+				}
+			}
+
+			pos += size
+			s = s[size:]
+		}
+
+		if isWord {
+			yield(word[:pos])
+		}
+	}
+}
+
+// SplitIter returns an iterator over the substrings of s separated by sep,
+// without allocating an intermediate slice.
+func SplitIter(s, sep string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		if sep == "" {
+			yield(s)
+			return
+		}
+
+		for {
+			i := strings.Index(s, sep)
+			if i < 0 {
+				yield(s)
+				return
+			}
+			if !yield(s[:i]) {
+				return
+			}
+			s = s[i+len(sep):]
+		}
+	}
+}
+
+// textLine is a line of text paired with the line ending ("", "\n" or
+// "\r\n") that followed it, so Indent/Dedent can rebuild s exactly.
+type textLine struct {
+	text   string
+	ending string
+}
+
+// splitLinesKeepEnding splits s into lines, keeping each line's ending.
+func splitLinesKeepEnding(s string) []textLine {
+	var lines []textLine
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '\n')
+		if i < 0 {
+			lines = append(lines, textLine{text: s})
+			break
+		}
+		if i > 0 && s[i-1] == '\r' {
+			lines = append(lines, textLine{text: s[:i-1], ending: "\r\n"})
+		} else {
+			lines = append(lines, textLine{text: s[:i], ending: "\n"})
+		}
+		s = s[i+1:]
+	}
+	return lines
+}
+
+// IndentOption configures Indent.
+type IndentOption func(*indentOptions)
+
+type indentOptions struct {
+	indentEmptyLines bool
+}
+
+// WithIndentEmptyLines makes Indent also prefix blank lines; by default
+// blank lines are left untouched.
+func WithIndentEmptyLines() IndentOption {
+	return func(o *indentOptions) { o.indentEmptyLines = true }
+}
+
+// Indent prefixes every line of s with prefix repeated width times. Blank
+// lines are left alone unless WithIndentEmptyLines is passed. "\r\n" line
+// endings are preserved.
+func Indent(s, prefix string, width int, opts ...IndentOption) string {
+	var options indentOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	pad := strings.Repeat(prefix, width)
+
+	var b strings.Builder
+	for _, line := range splitLinesKeepEnding(s) {
+		if line.text == "" && !options.indentEmptyLines {
+			b.WriteString(line.ending)
+			continue
+		}
+		b.WriteString(pad)
+		b.WriteString(line.text)
+		b.WriteString(line.ending)
+	}
+
+	return b.String()
+}
+
+// Dedent detects the common leading whitespace of every non-empty line in
+// s and strips it, useful for cleaning up multi-line heredoc-style strings.
+func Dedent(s string) string {
+	lines := splitLinesKeepEnding(s)
+
+	prefix := ""
+	havePrefix := false
+	for _, line := range lines {
+		if strings.TrimSpace(line.text) == "" {
+			continue
+		}
+		indent := line.text[:len(line.text)-len(strings.TrimLeft(line.text, " \t"))]
+		if !havePrefix {
+			prefix = indent
+			havePrefix = true
+			continue
+		}
+		prefix = commonPrefix(prefix, indent)
+	}
+
+	if prefix == "" {
+		return s
+	}
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(strings.TrimPrefix(line.text, prefix))
+		b.WriteString(line.ending)
+	}
+
+	return b.String()
+}
+
+// commonPrefix returns the longest common prefix of a and b.
+func commonPrefix(a, b string) string {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	i := 0
+	for i < n && a[i] == b[i] {
+		i++
+	}
+	return a[:i]
+}
+
+// splitIntoStrings splits s into words, each rendered fully upper or lower case.
+func splitIntoStrings(s string, upperCase bool) []string {
+	toks := splitCaseTokens(s, nil, true)
+	result := make([]string, len(toks))
+	for i, t := range toks {
+		if upperCase {
+			result[i] = strings.ToUpper(t.text)
+		} else {
+			result[i] = strings.ToLower(t.text)
+		}
+	}
+	return result
+}
+
+// isLetter reports whether r is a letter.
+func isLetter(r rune) bool {
+	return unicode.IsLetter(r)
+}
+
+// padAtPosition pads source with padStr up to size runes at position (0 both sides, 1 start, 2 end).
+func padAtPosition(source string, size int, padStr string, position int) string {
+	runes := []rune(source)
+	if padStr == "" || size <= len(runes) {
+		return source
+	}
+
+	diff := size - len(runes)
+	padRunes := []rune(padStr)
+
+	buildPadding := func(n int) string {
+		if n <= 0 {
+			return ""
+		}
+		padded := make([]rune, 0, n+len(padRunes))
+		for len(padded) < n {
+			padded = append(padded, padRunes...)
+		}
+		return string(padded[:n])
+	}
+
+	switch position {
+	case 1:
+		return buildPadding(diff) + source
+	case 2:
+		return source + buildPadding(diff)
+	default:
+		left := diff / 2
+		right := diff - left
+		return buildPadding(left) + source + buildPadding(right)
+	}
+}
+
 // WordCount return the number of meaningful word, word only contains alphabetic characters.
 func WordCount(s string) int {
 	var r rune
@@ -0,0 +1,79 @@
+package strutil
+
+import "testing"
+
+func TestPascalCase(t *testing.T) {
+	cases := []struct {
+		input  string
+		expect string
+	}{
+		{"", ""},
+		{"Foo-#1😄$_%^&*(1bar", "Foo11Bar"},
+		{"foo_bar", "FooBar"},
+		{"getURLValue", "GetUrlValue"},
+	}
+
+	for _, c := range cases {
+		if got := PascalCase(c.input); got != c.expect {
+			t.Errorf("PascalCase(%q) = %q, want %q", c.input, got, c.expect)
+		}
+	}
+}
+
+func TestCaseConverterInitialisms(t *testing.T) {
+	cc := &CaseConverter{Initialisms: []string{"ID", "URL"}, SplitOnDigits: true}
+
+	camelCases := []struct {
+		input  string
+		expect string
+	}{
+		{"getURLValue", "getURLValue"},
+		{"user_id", "userID"},
+	}
+	for _, c := range camelCases {
+		if got := cc.Camel(c.input); got != c.expect {
+			t.Errorf("Camel(%q) = %q, want %q", c.input, got, c.expect)
+		}
+	}
+
+	pascalCases := []struct {
+		input  string
+		expect string
+	}{
+		{"getURLValue", "GetURLValue"},
+		{"user_id", "UserID"},
+	}
+	for _, c := range pascalCases {
+		if got := cc.Pascal(c.input); got != c.expect {
+			t.Errorf("Pascal(%q) = %q, want %q", c.input, got, c.expect)
+		}
+	}
+}
+
+func TestCaseConverterAcronyms(t *testing.T) {
+	cc := &CaseConverter{Acronyms: map[string]string{"db": "DB"}}
+
+	if got := cc.Camel("user_db"); got != "userDB" {
+		t.Errorf("Camel(%q) = %q, want %q", "user_db", got, "userDB")
+	}
+	if got := cc.Pascal("user_db"); got != "UserDB" {
+		t.Errorf("Pascal(%q) = %q, want %q", "user_db", got, "UserDB")
+	}
+}
+
+func TestCaseConverterTrainDotPath(t *testing.T) {
+	cc := NewCaseConverter()
+
+	if got := cc.Train("foo_bar"); got != "Foo-Bar" {
+		t.Errorf("Train(%q) = %q, want %q", "foo_bar", got, "Foo-Bar")
+	}
+	if got := cc.Dot("foo_bar"); got != "foo.bar" {
+		t.Errorf("Dot(%q) = %q, want %q", "foo_bar", got, "foo.bar")
+	}
+	if got := cc.Path("foo_bar"); got != "foo/bar" {
+		t.Errorf("Path(%q) = %q, want %q", "foo_bar", got, "foo/bar")
+	}
+	if got := cc.ScreamingSnake("foo-bar"); got != "FOO_BAR" {
+		t.Errorf("ScreamingSnake(%q) = %q, want %q", "foo-bar", got, "FOO_BAR")
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2021 dudaodong@gmail.com. All rights reserved.
+// Use of this source code is governed by MIT license
+
+package strutil
+
+import (
+	"strings"
+	"unicode"
+)
+
+// defaultTransliterateTable backs Transliterate. It maps accented/extended
+// Latin, Cyrillic and Greek letters to their closest ASCII approximation;
+// casing is derived at call time from the source rune, so upper and lower
+// case runes share one lower case expansion. A few letters, such as 'ß',
+// are keyed by their lower case form only.
+var defaultTransliterateTable = newDefaultTransliterateTable()
+
+func newDefaultTransliterateTable() map[rune]string {
+	t := make(map[rune]string, 256)
+
+	add := func(expansion string, runes ...rune) {
+		for _, r := range runes {
+			t[r] = expansion
+		}
+	}
+
+	// Latin-1 Supplement
+	add("a", 'À', 'Á', 'Â', 'Ã', 'Ä', 'Å', 'à', 'á', 'â', 'ã', 'ä', 'å')
+	add("ae", 'Æ', 'æ')
+	add("c", 'Ç', 'ç')
+	add("e", 'È', 'É', 'Ê', 'Ë', 'è', 'é', 'ê', 'ë')
+	add("i", 'Ì', 'Í', 'Î', 'Ï', 'ì', 'í', 'î', 'ï')
+	add("d", 'Ð', 'ð')
+	add("n", 'Ñ', 'ñ')
+	add("o", 'Ò', 'Ó', 'Ô', 'Õ', 'Ö', 'Ø', 'ò', 'ó', 'ô', 'õ', 'ö', 'ø')
+	add("u", 'Ù', 'Ú', 'Û', 'Ü', 'ù', 'ú', 'û', 'ü')
+	add("y", 'Ý', 'ý', 'ÿ')
+	add("th", 'Þ', 'þ')
+	add("ss", 'ß')
+
+	// Latin Extended-A
+	add("a", 'Ā', 'ā', 'Ă', 'ă', 'Ą', 'ą')
+	add("c", 'Ć', 'ć', 'Ĉ', 'ĉ', 'Ċ', 'ċ', 'Č', 'č')
+	add("d", 'Ď', 'ď', 'Đ', 'đ')
+	add("e", 'Ē', 'ē', 'Ĕ', 'ĕ', 'Ė', 'ė', 'Ę', 'ę', 'Ě', 'ě')
+	add("g", 'Ĝ', 'ĝ', 'Ğ', 'ğ', 'Ġ', 'ġ', 'Ģ', 'ģ')
+	add("h", 'Ĥ', 'ĥ', 'Ħ', 'ħ')
+	add("i", 'Ĩ', 'ĩ', 'Ī', 'ī', 'Ĭ', 'ĭ', 'Į', 'į', 'İ', 'ı')
+	add("j", 'Ĵ', 'ĵ')
+	add("k", 'Ķ', 'ķ')
+	add("l", 'Ĺ', 'ĺ', 'Ļ', 'ļ', 'Ľ', 'ľ', 'Ŀ', 'ŀ', 'Ł', 'ł')
+	add("n", 'Ń', 'ń', 'Ņ', 'ņ', 'Ň', 'ň')
+	add("o", 'Ō', 'ō', 'Ŏ', 'ŏ', 'Ő', 'ő')
+	add("r", 'Ŕ', 'ŕ', 'Ŗ', 'ŗ', 'Ř', 'ř')
+	add("s", 'Ś', 'ś', 'Ŝ', 'ŝ', 'Ş', 'ş', 'Š', 'š')
+	add("t", 'Ţ', 'ţ', 'Ť', 'ť', 'Ŧ', 'ŧ')
+	add("u", 'Ũ', 'ũ', 'Ū', 'ū', 'Ŭ', 'ŭ', 'Ů', 'ů', 'Ű', 'ű', 'Ų', 'ų')
+	add("w", 'Ŵ', 'ŵ')
+	add("y", 'Ŷ', 'ŷ', 'Ÿ')
+	add("z", 'Ź', 'ź', 'Ż', 'ż', 'Ž', 'ž')
+
+	// Latin Extended-B
+	add("f", 'Ƒ', 'ƒ')
+
+	// Cyrillic
+	add("a", 'А', 'а')
+	add("b", 'Б', 'б')
+	add("v", 'В', 'в')
+	add("g", 'Г', 'г')
+	add("d", 'Д', 'д')
+	add("e", 'Е', 'е')
+	add("yo", 'Ё', 'ё')
+	add("zh", 'Ж', 'ж')
+	add("z", 'З', 'з')
+	add("i", 'И', 'и')
+	add("y", 'Й', 'й')
+	add("k", 'К', 'к')
+	add("l", 'Л', 'л')
+	add("m", 'М', 'м')
+	add("n", 'Н', 'н')
+	add("o", 'О', 'о')
+	add("p", 'П', 'п')
+	add("r", 'Р', 'р')
+	add("s", 'С', 'с')
+	add("t", 'Т', 'т')
+	add("u", 'У', 'у')
+	add("f", 'Ф', 'ф')
+	add("kh", 'Х', 'х')
+	add("ts", 'Ц', 'ц')
+	add("ch", 'Ч', 'ч')
+	add("sh", 'Ш', 'ш')
+	add("shch", 'Щ', 'щ')
+	add("", 'Ъ', 'ъ', 'Ь', 'ь')
+	add("y", 'Ы', 'ы')
+	add("e", 'Э', 'э')
+	add("yu", 'Ю', 'ю')
+	add("ya", 'Я', 'я')
+
+	// Greek
+	add("a", 'Α', 'α')
+	add("b", 'Β', 'β')
+	add("g", 'Γ', 'γ')
+	add("d", 'Δ', 'δ')
+	add("e", 'Ε', 'ε')
+	add("z", 'Ζ', 'ζ')
+	add("i", 'Η', 'η', 'Ι', 'ι')
+	add("th", 'Θ', 'θ')
+	add("k", 'Κ', 'κ')
+	add("l", 'Λ', 'λ')
+	add("m", 'Μ', 'μ')
+	add("n", 'Ν', 'ν')
+	add("x", 'Ξ', 'ξ')
+	add("o", 'Ο', 'ο', 'Ω', 'ω')
+	add("p", 'Π', 'π')
+	add("r", 'Ρ', 'ρ')
+	add("s", 'Σ', 'σ', 'ς')
+	add("t", 'Τ', 'τ')
+	add("y", 'Υ', 'υ')
+	add("f", 'Φ', 'φ')
+	add("ch", 'Χ', 'χ')
+	add("ps", 'Ψ', 'ψ')
+
+	return t
+}
+
+// Transliterate converts s to its closest ASCII approximation using the
+// built-in default table.
+// eg. "café" => "cafe", "Москва" => "Moskva"
+func Transliterate(s string) string {
+	return TransliterateWith(s, defaultTransliterateTable)
+}
+
+// TransliterateWith converts s to its closest ASCII approximation using
+// table instead of the built-in default. Runes not present in table pass
+// through unchanged. Multi-char expansions take their case from the source
+// rune and its neighbor: ЩИ -> SHCHI, Щи -> Shchi.
+func TransliterateWith(s string, table map[rune]string) string {
+	runes := []rune(s)
+	var b strings.Builder
+
+	for i, r := range runes {
+		expansion, ok := table[r]
+		if !ok {
+			b.WriteRune(r)
+			continue
+		}
+		if expansion == "" {
+			continue
+		}
+
+		switch {
+		case !unicode.IsUpper(r):
+			b.WriteString(expansion)
+		case i+1 < len(runes) && unicode.IsUpper(runes[i+1]):
+			b.WriteString(strings.ToUpper(expansion))
+		default:
+			b.WriteString(Capitalize(expansion))
+		}
+	}
+
+	return b.String()
+}
+
+// Slugify converts s into a URL-friendly slug: it transliterates s with
+// Transliterate, lowercases it, and joins runs of letters and digits with
+// "-". Letters missing from the default table are kept as they are, so the
+// slug is not always ASCII.
+// eg. "Héllo, World!" => "hello-world"
+func Slugify(s string) string {
+	transliterated := Transliterate(s)
+
+	var words []string
+	var word strings.Builder
+	for _, r := range transliterated {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			word.WriteRune(r)
+			continue
+		}
+		if word.Len() > 0 {
+			words = append(words, strings.ToLower(word.String()))
+			word.Reset()
+		}
+	}
+	if word.Len() > 0 {
+		words = append(words, strings.ToLower(word.String()))
+	}
+
+	return strings.Join(words, "-")
+}
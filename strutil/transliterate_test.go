@@ -0,0 +1,48 @@
+package strutil
+
+import "testing"
+
+func TestTransliterate(t *testing.T) {
+	cases := []struct {
+		input  string
+		expect string
+	}{
+		{"café", "cafe"},
+		{"ñandú", "nandu"},
+		{"straße", "strasse"},
+		{"øre", "ore"},
+		{"Щука", "Shchuka"},
+		{"ЩУКА", "SHCHUKA"},
+		{"hello", "hello"},
+	}
+
+	for _, c := range cases {
+		if got := Transliterate(c.input); got != c.expect {
+			t.Errorf("Transliterate(%q) = %q, want %q", c.input, got, c.expect)
+		}
+	}
+}
+
+func TestTransliterateWith(t *testing.T) {
+	table := map[rune]string{'@': "at"}
+	if got := TransliterateWith("a@b", table); got != "aatb" {
+		t.Errorf("TransliterateWith(%q) = %q, want %q", "a@b", got, "aatb")
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	cases := []struct {
+		input  string
+		expect string
+	}{
+		{"Héllo, World!", "hello-world"},
+		{"  Already-slug_ified  ", "already-slug-ified"},
+		{"Москва 2024", "moskva-2024"},
+	}
+
+	for _, c := range cases {
+		if got := Slugify(c.input); got != c.expect {
+			t.Errorf("Slugify(%q) = %q, want %q", c.input, got, c.expect)
+		}
+	}
+}